@@ -0,0 +1,37 @@
+// Command opgenesis writes the rollup.json and genesis.json fixtures for an
+// OP Stack chain, derived from the superchain-registry.
+package main
+
+import (
+	"flag"
+	"log"
+
+	opgenesis "github.com/trianglesphere/op-genesis"
+)
+
+func main() {
+	chainID := flag.Uint64("chain-id", 0, "L2 chain ID to generate fixtures for")
+	outDir := flag.String("out", ".", "directory to write rollup.json and genesis.json into")
+	network := flag.String("network", "", "superchain network to pull overrides from (mainnet, sepolia, sepolia-dev-0); defaults to looking -chain-id up in the superchain-registry")
+	flag.Parse()
+
+	if *chainID == 0 {
+		log.Fatal("-chain-id is required")
+	}
+
+	opts, err := overridesFor(*chainID, *network)
+	if err != nil {
+		log.Fatalf("resolving overrides for chain %d: %v", *chainID, err)
+	}
+
+	if err := opgenesis.Generate(*chainID, *outDir, opts); err != nil {
+		log.Fatalf("generating fixtures for chain %d: %v", *chainID, err)
+	}
+}
+
+func overridesFor(chainID uint64, network string) (opgenesis.GenerateOptions, error) {
+	if network != "" {
+		return opgenesis.OverridesForNetwork(network)
+	}
+	return opgenesis.OverridesForChain(chainID)
+}
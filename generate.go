@@ -0,0 +1,63 @@
+package opgenesis
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// GenerateOptions controls how Generate materializes the rollup.json and
+// genesis.json fixtures for a chain. The overrides mirror the ones applied
+// in the TestCase table in genesis_test.go, and should be supplied whenever
+// the registry config for the chain's superchain is not yet final.
+type GenerateOptions struct {
+	GethOverride func(*params.ChainConfig)
+	NodeOverride func(*rollup.Config)
+}
+
+// Generate writes rollup.json and genesis.json for chainID into outDir,
+// derived from the superchain-registry via rollup.LoadOPStackRollupConfig
+// and core.LoadOPStackGenesis. The emitted files are the canonical form
+// consumed by testRollupConfig/testGenesisConfig/testGenesisHash: re-reading
+// them and hashing the resulting block must match core.LoadOPStackGenesis(chainID).
+func Generate(chainID uint64, outDir string, opts GenerateOptions) error {
+	rollupConfig, err := rollup.LoadOPStackRollupConfig(chainID)
+	if err != nil {
+		return fmt.Errorf("loading rollup config for chain %d: %w", chainID, err)
+	}
+	if opts.NodeOverride != nil {
+		opts.NodeOverride(rollupConfig)
+	}
+
+	genesis, err := core.LoadOPStackGenesis(chainID)
+	if err != nil {
+		return fmt.Errorf("loading genesis for chain %d: %w", chainID, err)
+	}
+	if opts.GethOverride != nil {
+		opts.GethOverride(genesis.Config)
+	}
+
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		return fmt.Errorf("creating output dir %s: %w", outDir, err)
+	}
+	if err := writeJson(filepath.Join(outDir, "rollup.json"), rollupConfig); err != nil {
+		return fmt.Errorf("writing rollup.json: %w", err)
+	}
+	if err := writeJson(filepath.Join(outDir, "genesis.json"), genesis); err != nil {
+		return fmt.Errorf("writing genesis.json: %w", err)
+	}
+	return nil
+}
+
+func writeJson(path string, v any) error {
+	content, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, content, 0o644)
+}
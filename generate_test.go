@@ -0,0 +1,30 @@
+package opgenesis
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerateRoundTrip checks that Generate's output for a tracked chain
+// reads back as the same genesis block the superchain-registry produces.
+// Generate already bakes the network override into what it writes, so the
+// files it emits are the final, non-stale form: reading them back needs no
+// further override.
+func TestGenerateRoundTrip(t *testing.T) {
+	const chainID = 8453 // Base Mainnet
+	outDir := t.TempDir()
+
+	opts, err := OverridesForChain(chainID)
+	require.NoError(t, err)
+
+	require.NoError(t, Generate(chainID, outDir, opts))
+
+	rollupPath := filepath.Join(outDir, "rollup.json")
+	genesisPath := filepath.Join(outDir, "genesis.json")
+
+	testRollupConfig(t, rollupPath, chainID, nil)
+	testGenesisConfig(t, genesisPath, chainID, nil)
+	testGenesisHash(t, genesisPath, chainID)
+}
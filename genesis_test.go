@@ -4,10 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
-	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum-optimism/superchain-registry/superchain"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/require"
@@ -21,50 +22,16 @@ type TestCase struct {
 	nodeOverride func(*rollup.Config)
 }
 
-func u64p(x uint64) *uint64 {
-	return &x
-}
-
-var (
-	MainnetProtocolVersionsAddress = common.HexToAddress("0x8062AbC286f5e7D9428a0Ccb9AbD71e50d93b935")
-	SepoliaProtocolVersionsAddress = common.HexToAddress("0x79ADD5713B383DAa0a138d3C4780C7A1804a8090")
-	GoerliProtocolVersionsAddress  = common.HexToAddress("0x0C24F5098774aA366827D667494e9F889f7cFc08")
-
-	MainnetCanyonTime = u64p(1704992401)
-	SepoliaCanyonTime = u64p(1699981200)
-	GoerliCanyonTime  = u64p(1699981200)
-
-	// MainnetDeltaTime = u64p()
-	SepoliaDeltaTime = u64p(1703203200)
-	GoerliDeltaTime  = u64p(1703116800)
-)
-
-var mainnetGethOverride = func(cfg *params.ChainConfig) {
-	cfg.ShanghaiTime = MainnetCanyonTime
-	cfg.CanyonTime = MainnetCanyonTime
-	cfg.Optimism.EIP1559DenominatorCanyon = 250
-}
-
-var mainnetNodeOverride = func(cfg *rollup.Config) {
-	cfg.CanyonTime = MainnetCanyonTime
-	cfg.ProtocolVersionsAddress = MainnetProtocolVersionsAddress
-}
-
-var sepoliaGethOverride = func(cfg *params.ChainConfig) {
-	cfg.ShanghaiTime = SepoliaCanyonTime
-	cfg.CanyonTime = SepoliaCanyonTime
-	cfg.Optimism.EIP1559DenominatorCanyon = 250
-}
-
-var sepoliaNodeOverride = func(cfg *rollup.Config) {
-	cfg.CanyonTime = SepoliaCanyonTime
-	cfg.DeltaTime = SepoliaDeltaTime
-	cfg.ProtocolVersionsAddress = SepoliaProtocolVersionsAddress
-}
-
 func TestConfigs(t *testing.T) {
 	tests := []TestCase{
 		// Mainnet
+		{
+			name:         "OP Mainnet",
+			path:         "data/mainnet/op",
+			chainID:      10,
+			gethOverride: mainnetGethOverride,
+			nodeOverride: mainnetNodeOverride,
+		},
 		{
 			name:         "Base Mainnet",
 			path:         "data/mainnet/base",
@@ -76,8 +43,8 @@ func TestConfigs(t *testing.T) {
 			name:         "PGN Mainnet",
 			path:         "data/mainnet/pgn",
 			chainID:      424,
-			gethOverride: mainnetGethOverride,
-			nodeOverride: mainnetNodeOverride,
+			gethOverride: pgnMainnetGethOverride,
+			nodeOverride: pgnMainnetNodeOverride,
 		},
 		{
 			name:         "Zora Mainnet",
@@ -87,6 +54,13 @@ func TestConfigs(t *testing.T) {
 			nodeOverride: mainnetNodeOverride,
 		},
 		// Sepolia
+		{
+			name:         "OP Sepolia",
+			path:         "data/sepolia/op",
+			chainID:      11155420,
+			gethOverride: sepoliaGethOverride,
+			nodeOverride: sepoliaNodeOverride,
+		},
 		{
 			name:         "Base Sepolia",
 			path:         "data/sepolia/base",
@@ -108,22 +82,13 @@ func TestConfigs(t *testing.T) {
 			gethOverride: sepoliaGethOverride,
 			nodeOverride: sepoliaNodeOverride,
 		},
-		// Goerli
+		// Sepolia devnet (sepolia-dev-0)
 		{
-			name:    "Base Goerli",
-			path:    "data/goerli/base",
-			chainID: 84531,
-			gethOverride: func(cfg *params.ChainConfig) {
-				cfg.RegolithTime = u64p(1683219600) // Not set in Base Genesis but set in base rollup.json
-				cfg.ShanghaiTime = GoerliCanyonTime
-				cfg.CanyonTime = GoerliCanyonTime
-				cfg.Optimism.EIP1559DenominatorCanyon = 250
-			},
-			nodeOverride: func(cfg *rollup.Config) {
-				cfg.CanyonTime = GoerliCanyonTime
-				cfg.DeltaTime = GoerliDeltaTime
-				cfg.ProtocolVersionsAddress = GoerliProtocolVersionsAddress
-			},
+			name:         "OP Sepolia Devnet 0",
+			path:         "data/sepolia-dev-0/op",
+			chainID:      11155421,
+			gethOverride: sepoliaDevnetGethOverride,
+			nodeOverride: sepoliaDevnetNodeOverride,
 		},
 	}
 	for _, test := range tests {
@@ -132,6 +97,35 @@ func TestConfigs(t *testing.T) {
 	}
 }
 
+// TestAllRegistryChains walks every chain the superchain-registry knows
+// about and verifies it against the local fixtures in data/<network>/<chain>.
+// Chains without a local fixture are skipped, so dropping a new superchain
+// into the registry does not require editing this file.
+func TestAllRegistryChains(t *testing.T) {
+	for chainID, chain := range superchain.OPChains {
+		chainID, chain := chainID, chain
+		t.Run(chain.Chain, func(t *testing.T) {
+			path := fmt.Sprintf("data/%s/%s", chain.Superchain, chain.Chain)
+			rollupPath := fmt.Sprintf("%s/rollup.json", path)
+			genesisPath := fmt.Sprintf("%s/genesis.json", path)
+			if _, err := os.Stat(rollupPath); os.IsNotExist(err) {
+				t.Skipf("no local fixture for %s at %s, skipping", chain.Chain, path)
+			}
+			if _, err := os.Stat(genesisPath); os.IsNotExist(err) {
+				t.Skipf("no local genesis fixture for %s at %s, skipping", chain.Chain, path)
+			}
+
+			gethOverride, nodeOverride := networkGethOverrides[chain.Superchain], networkNodeOverrides[chain.Superchain]
+			if chainID == 424 { // PGN Mainnet does not take the mainnet later-fork overrides
+				gethOverride, nodeOverride = pgnMainnetGethOverride, pgnMainnetNodeOverride
+			}
+			testRollupConfig(t, rollupPath, chainID, nodeOverride)
+			testGenesisConfig(t, genesisPath, chainID, gethOverride)
+			testGenesisHash(t, genesisPath, chainID)
+		})
+	}
+}
+
 func (tc *TestCase) Run(t *testing.T) {
 	rollupPath := fmt.Sprintf("%s/rollup.json", tc.path)
 	genesisPath := fmt.Sprintf("%s/genesis.json", tc.path)
@@ -140,6 +134,14 @@ func (tc *TestCase) Run(t *testing.T) {
 	testGenesisHash(t, genesisPath, tc.chainID)
 }
 
+// TestNoGoerliFixtures guards against Goerli fixtures reappearing now that
+// upstream has dropped Goerli support entirely: if data/goerli/ exists,
+// something has regressed this cleanup.
+func TestNoGoerliFixtures(t *testing.T) {
+	_, err := os.Stat("data/goerli")
+	require.True(t, os.IsNotExist(err), "data/goerli must not exist: Goerli fixtures have been retired")
+}
+
 func testRollupConfig(t *testing.T, path string, chainID uint64, override func(*rollup.Config)) {
 	var config rollup.Config
 	err := readJson(path, &config)
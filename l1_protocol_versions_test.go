@@ -0,0 +1,192 @@
+package opgenesis
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/require"
+)
+
+// protocolVersionsABI covers the two view getters on the L1 ProtocolVersions
+// contract that the superchain-registry derives MainnetProtocolVersionsAddress
+// and friends from. Both return the packed ProtocolVersion encoding: a single
+// version byte, a 7-byte build identifier, and four big-endian uint32 fields
+// (major, minor, patch, prerelease), left-padded to 32 bytes.
+const protocolVersionsABI = `[
+	{"inputs":[],"name":"required","outputs":[{"internalType":"uint256","name":"out","type":"uint256"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"recommended","outputs":[{"internalType":"uint256","name":"out","type":"uint256"}],"stateMutability":"view","type":"function"}
+]`
+
+type protocolVersion struct {
+	build      [7]byte
+	major      uint32
+	minor      uint32
+	patch      uint32
+	prerelease uint32
+}
+
+// parseProtocolVersion decodes the packed layout described above out of the
+// big-endian 32-byte word returned by the contract.
+func parseProtocolVersion(v *big.Int) (protocolVersion, error) {
+	word := v.Bytes()
+	if len(word) > 32 {
+		return protocolVersion{}, fmt.Errorf("protocol version word too long: %d bytes", len(word))
+	}
+	var buf [32]byte
+	copy(buf[32-len(word):], word)
+
+	var out protocolVersion
+	copy(out.build[:], buf[9:16])
+	out.major = bigEndianUint32(buf[16:20])
+	out.minor = bigEndianUint32(buf[20:24])
+	out.patch = bigEndianUint32(buf[24:28])
+	out.prerelease = bigEndianUint32(buf[28:32])
+	return out, nil
+}
+
+func bigEndianUint32(b []byte) uint32 {
+	return uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+}
+
+// forkMajors maps the name of the latest OP Stack fork active at a given
+// time to the ProtocolVersion major version node operators are expected to
+// be running, per the superchain-registry's protocol version table. Forks
+// not yet listed here have not had a corresponding ProtocolVersions bump.
+// TestForkMajorsCoversAllForkTimes below fails if rollup.Config grows a new
+// activation-time field that isn't represented here, so this can't silently
+// go stale the way a hand-maintained "latest fork" list would.
+var forkMajors = map[string]uint32{
+	"canyon":  4,
+	"delta":   5,
+	"ecotone": 6,
+	"fjord":   7,
+	"granite": 8,
+}
+
+// forkTimeFields reflects over cfg and returns every activation-time field
+// (a *uint64 whose name ends in "Time") keyed by its fork name, e.g.
+// "GraniteTime" -> "granite". Discovering fields this way means a newer
+// fork added to rollup.Config is picked up automatically instead of being
+// silently invisible to a hardcoded fork list.
+func forkTimeFields(cfg *rollup.Config) map[string]*uint64 {
+	fields := make(map[string]*uint64)
+	v := reflect.ValueOf(cfg).Elem()
+	t := v.Type()
+	uint64PtrType := reflect.TypeOf((*uint64)(nil))
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Type != uint64PtrType || !strings.HasSuffix(field.Name, "Time") {
+			continue
+		}
+		name := strings.ToLower(strings.TrimSuffix(field.Name, "Time"))
+		fields[name] = v.Field(i).Interface().(*uint64)
+	}
+	return fields
+}
+
+// latestActiveFork returns the name of the fork in cfg with the latest
+// activation time that has already passed as of now, or "" if none have
+// activated yet.
+func latestActiveFork(cfg *rollup.Config, now uint64) string {
+	var latestName string
+	var latestTime uint64
+	for name, activation := range forkTimeFields(cfg) {
+		if activation == nil || *activation > now {
+			continue
+		}
+		if latestName == "" || *activation > latestTime {
+			latestName, latestTime = name, *activation
+		}
+	}
+	return latestName
+}
+
+// testProtocolVersionsOnChain dials rpcURL, reads the ProtocolVersions
+// contract's recommended()/required() getters at the chain's configured
+// ProtocolVersionsAddress, and checks that the result is well-formed and
+// that the on-chain required major matches the chain's latest activated
+// fork. It is an integration test: skipped unless rpcURL is non-empty.
+func testProtocolVersionsOnChain(t *testing.T, rpcURL string, cfg *rollup.Config) {
+	if rpcURL == "" {
+		t.Skip("no L1 RPC configured, skipping on-chain ProtocolVersions check")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := ethclient.DialContext(ctx, rpcURL)
+	require.NoError(t, err)
+	defer client.Close()
+
+	code, err := client.CodeAt(ctx, cfg.ProtocolVersionsAddress, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, code, "ProtocolVersionsAddress %s has no code on %s", cfg.ProtocolVersionsAddress, rpcURL)
+
+	parsed, err := abi.JSON(strings.NewReader(protocolVersionsABI))
+	require.NoError(t, err)
+
+	call := func(method string) *big.Int {
+		data, err := parsed.Pack(method)
+		require.NoError(t, err)
+		msg := ethereum.CallMsg{To: &cfg.ProtocolVersionsAddress, Data: data}
+		out, err := client.CallContract(ctx, msg, nil)
+		require.NoError(t, err)
+		result, err := parsed.Unpack(method, out)
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		return result[0].(*big.Int)
+	}
+
+	recommended, err := parseProtocolVersion(call("recommended"))
+	require.NoError(t, err)
+	required, err := parseProtocolVersion(call("required"))
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, recommended.major, required.major, "recommended major must never lag required major")
+
+	fork := latestActiveFork(cfg, uint64(time.Now().Unix()))
+	wantMajor, ok := forkMajors[fork]
+	if !ok {
+		t.Skipf("no known ProtocolVersion major for latest active fork %q, skipping major check", fork)
+	}
+	require.Equal(t, wantMajor, required.major, "on-chain required major does not match the major expected for the %s fork", fork)
+}
+
+// TestMainnetProtocolVersions cross-checks MainnetProtocolVersionsAddress
+// against a live L1 mainnet node. Opt-in: set OPGENESIS_L1_RPC_MAINNET to an
+// L1 mainnet RPC endpoint to run it.
+func TestMainnetProtocolVersions(t *testing.T) {
+	cfg, err := rollup.LoadOPStackRollupConfig(10) // OP Mainnet
+	require.NoError(t, err)
+	mainnetNodeOverride(cfg)
+	testProtocolVersionsOnChain(t, os.Getenv("OPGENESIS_L1_RPC_MAINNET"), cfg)
+}
+
+// TestSepoliaProtocolVersions cross-checks SepoliaProtocolVersionsAddress
+// against a live L1 Sepolia node. Opt-in: set OPGENESIS_L1_RPC_SEPOLIA to an
+// L1 Sepolia RPC endpoint to run it.
+func TestSepoliaProtocolVersions(t *testing.T) {
+	cfg, err := rollup.LoadOPStackRollupConfig(11155420) // OP Sepolia
+	require.NoError(t, err)
+	sepoliaNodeOverride(cfg)
+	testProtocolVersionsOnChain(t, os.Getenv("OPGENESIS_L1_RPC_SEPOLIA"), cfg)
+}
+
+// TestForkMajorsCoversAllForkTimes guards against forkMajors going stale: if
+// rollup.Config gains a new fork activation-time field, this fails until
+// that fork's ProtocolVersion major is added to forkMajors.
+func TestForkMajorsCoversAllForkTimes(t *testing.T) {
+	for name := range forkTimeFields(&rollup.Config{}) {
+		_, ok := forkMajors[name]
+		require.True(t, ok, "rollup.Config has a %q fork activation time with no entry in forkMajors", name)
+	}
+}
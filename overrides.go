@@ -0,0 +1,160 @@
+package opgenesis
+
+import (
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/superchain-registry/superchain"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func u64p(x uint64) *uint64 {
+	return &x
+}
+
+var (
+	MainnetProtocolVersionsAddress       = common.HexToAddress("0x8062AbC286f5e7D9428a0Ccb9AbD71e50d93b935")
+	SepoliaProtocolVersionsAddress       = common.HexToAddress("0x79ADD5713B383DAa0a138d3C4780C7A1804a8090")
+	SepoliaDevnetProtocolVersionsAddress = common.HexToAddress("0x252CbE9517F731C618961F890DdB11F89a76b120")
+
+	MainnetCanyonTime       = u64p(1704992401)
+	SepoliaCanyonTime       = u64p(1699981200)
+	SepoliaDevnetCanyonTime = u64p(1699981200)
+
+	// MainnetDeltaTime = u64p()
+	SepoliaDeltaTime       = u64p(1703203200)
+	SepoliaDevnetDeltaTime = u64p(1702579200)
+
+	MainnetEcotoneTime       = u64p(1710374401)
+	SepoliaEcotoneTime       = u64p(1708534800)
+	SepoliaDevnetEcotoneTime = u64p(1707238800)
+
+	MainnetFjordTime       = u64p(1720627201)
+	SepoliaFjordTime       = u64p(1716998400)
+	SepoliaDevnetFjordTime = u64p(1715961600)
+
+	MainnetGraniteTime       = u64p(1726070401)
+	SepoliaGraniteTime       = u64p(1723478400)
+	SepoliaDevnetGraniteTime = u64p(1722470400)
+)
+
+var mainnetGethOverride = func(cfg *params.ChainConfig) {
+	cfg.ShanghaiTime = MainnetCanyonTime
+	cfg.CanyonTime = MainnetCanyonTime
+	cfg.Optimism.EIP1559DenominatorCanyon = 250
+	cfg.CancunTime = MainnetEcotoneTime
+	cfg.EcotoneTime = MainnetEcotoneTime
+	cfg.FjordTime = MainnetFjordTime
+	cfg.GraniteTime = MainnetGraniteTime
+}
+
+var mainnetNodeOverride = func(cfg *rollup.Config) {
+	cfg.CanyonTime = MainnetCanyonTime
+	cfg.EcotoneTime = MainnetEcotoneTime
+	cfg.FjordTime = MainnetFjordTime
+	cfg.GraniteTime = MainnetGraniteTime
+	cfg.ProtocolVersionsAddress = MainnetProtocolVersionsAddress
+}
+
+// pgnMainnetGethOverride and pgnMainnetNodeOverride cover PGN Mainnet, which
+// was sunset in 2024 before Ecotone/Fjord/Granite activated on the rest of
+// the superchain. Its genesis was never updated past Canyon, so it must not
+// share mainnetGethOverride/mainnetNodeOverride's later-fork fields.
+var pgnMainnetGethOverride = func(cfg *params.ChainConfig) {
+	cfg.ShanghaiTime = MainnetCanyonTime
+	cfg.CanyonTime = MainnetCanyonTime
+	cfg.Optimism.EIP1559DenominatorCanyon = 250
+}
+
+var pgnMainnetNodeOverride = func(cfg *rollup.Config) {
+	cfg.CanyonTime = MainnetCanyonTime
+	cfg.ProtocolVersionsAddress = MainnetProtocolVersionsAddress
+}
+
+var sepoliaGethOverride = func(cfg *params.ChainConfig) {
+	cfg.ShanghaiTime = SepoliaCanyonTime
+	cfg.CanyonTime = SepoliaCanyonTime
+	cfg.Optimism.EIP1559DenominatorCanyon = 250
+	cfg.CancunTime = SepoliaEcotoneTime
+	cfg.EcotoneTime = SepoliaEcotoneTime
+	cfg.FjordTime = SepoliaFjordTime
+	cfg.GraniteTime = SepoliaGraniteTime
+}
+
+var sepoliaNodeOverride = func(cfg *rollup.Config) {
+	cfg.CanyonTime = SepoliaCanyonTime
+	cfg.DeltaTime = SepoliaDeltaTime
+	cfg.EcotoneTime = SepoliaEcotoneTime
+	cfg.FjordTime = SepoliaFjordTime
+	cfg.GraniteTime = SepoliaGraniteTime
+	cfg.ProtocolVersionsAddress = SepoliaProtocolVersionsAddress
+}
+
+// sepoliaDevnetGethOverride and sepoliaDevnetNodeOverride cover the
+// sepolia-dev-0 devnet, which activates forks ahead of public Sepolia and
+// has its own ProtocolVersions deployment.
+var sepoliaDevnetGethOverride = func(cfg *params.ChainConfig) {
+	cfg.ShanghaiTime = SepoliaDevnetCanyonTime
+	cfg.CanyonTime = SepoliaDevnetCanyonTime
+	cfg.Optimism.EIP1559DenominatorCanyon = 250
+	cfg.CancunTime = SepoliaDevnetEcotoneTime
+	cfg.EcotoneTime = SepoliaDevnetEcotoneTime
+	cfg.FjordTime = SepoliaDevnetFjordTime
+	cfg.GraniteTime = SepoliaDevnetGraniteTime
+}
+
+var sepoliaDevnetNodeOverride = func(cfg *rollup.Config) {
+	cfg.CanyonTime = SepoliaDevnetCanyonTime
+	cfg.DeltaTime = SepoliaDevnetDeltaTime
+	cfg.EcotoneTime = SepoliaDevnetEcotoneTime
+	cfg.FjordTime = SepoliaDevnetFjordTime
+	cfg.GraniteTime = SepoliaDevnetGraniteTime
+	cfg.ProtocolVersionsAddress = SepoliaDevnetProtocolVersionsAddress
+}
+
+// networkGethOverrides and networkNodeOverrides key the per-superchain
+// override closures by superchain name so both TestAllRegistryChains and
+// OverridesForNetwork can look them up without hardcoding chain identity.
+var networkGethOverrides = map[string]func(*params.ChainConfig){
+	"mainnet":       mainnetGethOverride,
+	"sepolia":       sepoliaGethOverride,
+	"sepolia-dev-0": sepoliaDevnetGethOverride,
+}
+
+var networkNodeOverrides = map[string]func(*rollup.Config){
+	"mainnet":       mainnetNodeOverride,
+	"sepolia":       sepoliaNodeOverride,
+	"sepolia-dev-0": sepoliaDevnetNodeOverride,
+}
+
+// OverridesForNetwork returns the GenerateOptions for the given superchain
+// network (e.g. "mainnet", "sepolia", "sepolia-dev-0"), or an error if the
+// network has no registered override.
+func OverridesForNetwork(network string) (GenerateOptions, error) {
+	gethOverride, ok := networkGethOverrides[network]
+	if !ok {
+		return GenerateOptions{}, fmt.Errorf("unknown superchain network %q", network)
+	}
+	return GenerateOptions{
+		GethOverride: gethOverride,
+		NodeOverride: networkNodeOverrides[network],
+	}, nil
+}
+
+// OverridesForChain looks chainID up in the superchain-registry to find the
+// superchain network it belongs to, then returns that network's
+// GenerateOptions. PGN Mainnet (chain 424) gets pgnMainnetGethOverride and
+// pgnMainnetNodeOverride instead of the mainnet ones. It is the lookup
+// Generate's callers need so they don't have to know up front which network
+// a chain ID maps to.
+func OverridesForChain(chainID uint64) (GenerateOptions, error) {
+	chain, ok := superchain.OPChains[chainID]
+	if !ok {
+		return GenerateOptions{}, fmt.Errorf("chain %d is not present in the superchain-registry", chainID)
+	}
+	if chainID == 424 {
+		return GenerateOptions{GethOverride: pgnMainnetGethOverride, NodeOverride: pgnMainnetNodeOverride}, nil
+	}
+	return OverridesForNetwork(chain.Superchain)
+}